@@ -0,0 +1,55 @@
+package plugin
+
+// InstallConfig describes where a plugin is published and how its release
+// artifacts should be verified before being installed.
+type InstallConfig struct {
+	// SourceHost, SourceOwner and SourceRepo identify the GitHub repository
+	// the plugin is published from (e.g. "github.com", "terraform-linters", "tflint-ruleset-aws").
+	SourceHost  string
+	SourceOwner string
+	SourceRepo  string
+
+	// SigningKey is an ASCII armored OpenPGP public key used to verify legacy,
+	// non-keyless signed releases. See SignatureChecker.GetSigningKey.
+	SigningKey string
+
+	// RekorURL overrides the Rekor transparency log server queried by
+	// findTLogEntries. Defaults to the public Sigstore Rekor instance.
+	RekorURL string
+
+	// TUFRootURL overrides the TUF repository mirror the Sigstore trusted root
+	// (Fulcio/Rekor/CTlog/TSA keys) is fetched from. Defaults to the public
+	// Sigstore TUF CDN.
+	TUFRootURL string
+
+	// TUFRootJSONPath, if set, bootstraps the TUF client from a local root.json
+	// instead of the well-known public root, for enterprises running their own
+	// TUF repository alongside a private Fulcio/Rekor deployment.
+	TUFRootJSONPath string
+
+	// FulcioOIDCIssuer overrides the OIDC issuer expected in the Fulcio
+	// certificate identity. Defaults to GitHub Actions' issuer.
+	FulcioOIDCIssuer string
+
+	// ExpectedSANRegex overrides the regex the certificate's SAN must match.
+	// Defaults to a regex derived from SourceHost/SourceOwner/SourceRepo.
+	ExpectedSANRegex string
+
+	// OfflineVerification, if true, verifies keyless signatures without contacting Rekor.
+	// The caller must supply a cached transparency log entry (see
+	// SignatureChecker.WarmVerificationCache and VerifyKeylessOffline) instead.
+	OfflineVerification bool
+
+	// SignerWorkflowRef, if set, restricts keyless verification to certificates whose Fulcio
+	// Build Signer URI extension matches this glob (e.g. ".github/workflows/release.yml@refs/tags/v*"),
+	// rather than accepting a signature from any workflow in SourceOwner/SourceRepo.
+	SignerWorkflowRef string
+
+	// SignerRunnerEnvironment, if set, restricts keyless verification to certificates whose
+	// Fulcio Runner Environment extension matches exactly (e.g. "github-hosted").
+	SignerRunnerEnvironment string
+
+	// RequireReusableWorkflow, if true, rejects certificates signed directly by a repository's
+	// own workflow, requiring the signature to come from a called reusable workflow instead.
+	RequireReusableWorkflow bool
+}
@@ -0,0 +1,118 @@
+package plugin
+
+import (
+	"crypto"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/sigstore/sigstore-go/pkg/root"
+)
+
+// oidSCTList is the X.509v3 extension OID Fulcio/CTFE embed the certificate's Signed Certificate
+// Timestamps under, per RFC 6962 section 3.3.
+var oidSCTList = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 11129, 2, 4, 2}
+
+// Keyring looks up a Rekor or CTFE public key by log ID, the SHA-256 of the DER-encoded
+// SubjectPublicKeyInfo, matching the log-id semantics Rekor and CTFE themselves use. A TUF
+// trusted root carries every current and historical key for a log, so resolving through a
+// Keyring (rather than assuming whichever key is "current") keeps verification working across
+// Rekor/CTFE key rotations and for plugins signed against an older shard.
+type Keyring struct {
+	keys map[string]crypto.PublicKey
+}
+
+// LoadKeyringFromTrustedRoot loads the Rekor and CTFE keyrings from every transparency log and
+// CT log key in trustedRoot.
+func LoadKeyringFromTrustedRoot(trustedRoot *root.TrustedRoot) (rekorKeyring *Keyring, ctfeKeyring *Keyring, err error) {
+	rekorKeyring, err = keyringFromLogs(trustedRoot.RekorLogs())
+	if err != nil {
+		return nil, nil, fmt.Errorf("loading rekor keyring: %w", err)
+	}
+
+	ctfeKeyring, err = keyringFromLogs(trustedRoot.CTLogs())
+	if err != nil {
+		return nil, nil, fmt.Errorf("loading ctfe keyring: %w", err)
+	}
+
+	return rekorKeyring, ctfeKeyring, nil
+}
+
+func keyringFromLogs(logs map[string]*root.TransparencyLog) (*Keyring, error) {
+	keys := make(map[string]crypto.PublicKey, len(logs))
+	for _, l := range logs {
+		id, err := logID(l.PublicKey)
+		if err != nil {
+			return nil, err
+		}
+		keys[id] = l.PublicKey
+	}
+	return &Keyring{keys: keys}, nil
+}
+
+// logID computes the SHA-256 of the DER-encoded SubjectPublicKeyInfo, matching how Rekor and
+// CTFE derive the log ID embedded in a tlog.Entry's LogID or an SCT's LogID.
+func logID(pub crypto.PublicKey) (string, error) {
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		return "", fmt.Errorf("marshaling public key: %w", err)
+	}
+	sum := sha256.Sum256(der)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// Key returns the public key for the log identified by logID (hex-encoded), as found in a
+// tlog.Entry's LogID or an SCT's LogID.
+func (k *Keyring) Key(logID string) (crypto.PublicKey, error) {
+	key, ok := k.keys[logID]
+	if !ok {
+		return nil, fmt.Errorf("no public key found for log ID %q, the log may have rotated since the trusted root was last refreshed", logID)
+	}
+	return key, nil
+}
+
+// sctLogIDs extracts the hex-encoded log IDs of every Signed Certificate Timestamp embedded in
+// cert's RFC 6962 SCT list extension, so they can be checked against a CTFE Keyring.
+func sctLogIDs(cert *x509.Certificate) ([]string, error) {
+	var raw []byte
+	found := false
+	for _, ext := range cert.Extensions {
+		if ext.Id.Equal(oidSCTList) {
+			found = true
+			if _, err := asn1.Unmarshal(ext.Value, &raw); err != nil {
+				return nil, fmt.Errorf("parsing SCT list extension: %w", err)
+			}
+			break
+		}
+	}
+	if !found {
+		return nil, errors.New("certificate has no embedded signed certificate timestamps")
+	}
+
+	// raw is a 2-byte big-endian length followed by a sequence of (2-byte length, SCT) entries.
+	// Each SCT is 1 version byte, a 32-byte log ID, then the timestamp/extensions/signature we
+	// don't need here. @see https://datatracker.ietf.org/doc/html/rfc6962#section-3.2
+	if len(raw) < 2 {
+		return nil, errors.New("malformed SCT list")
+	}
+	list := raw[2:]
+
+	var logIDs []string
+	for len(list) > 0 {
+		if len(list) < 2 {
+			return nil, errors.New("malformed SCT list entry")
+		}
+		sctLen := int(list[0])<<8 | int(list[1])
+		list = list[2:]
+		if len(list) < sctLen || sctLen < 1+32 {
+			return nil, errors.New("malformed SCT list entry")
+		}
+		sct := list[:sctLen]
+		logIDs = append(logIDs, hex.EncodeToString(sct[1:33]))
+		list = list[sctLen:]
+	}
+	return logIDs, nil
+}
@@ -0,0 +1,120 @@
+package plugin
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"math/big"
+	"testing"
+	"time"
+)
+
+// buildSCTListExtension hand-builds an RFC 6962 section 3.3 SCT list extension value out of the
+// given log IDs, matching the byte layout documented on sctLogIDs.
+func buildSCTListExtension(t *testing.T, logIDs ...[32]byte) []byte {
+	t.Helper()
+
+	var list []byte
+	for _, id := range logIDs {
+		// version (1 byte) + log ID (32 bytes) + timestamp (8 bytes) + extensions length (2
+		// bytes) + signature (4 bytes, arbitrary placeholder) we don't parse.
+		sct := make([]byte, 0, 1+32+8+2+4)
+		sct = append(sct, 0)
+		sct = append(sct, id[:]...)
+		sct = append(sct, make([]byte, 8+2+4)...)
+
+		list = append(list, byte(len(sct)>>8), byte(len(sct)))
+		list = append(list, sct...)
+	}
+
+	// sctLogIDs expects raw (the asn1.Unmarshal'd OCTET STRING content) to start with a 2-byte
+	// overall length of the entries that follow.
+	sctList := append([]byte{byte(len(list) >> 8), byte(len(list))}, list...)
+
+	extValue, err := asn1.Marshal(sctList)
+	if err != nil {
+		t.Fatalf("marshaling SCT list extension: %v", err)
+	}
+	return extValue
+}
+
+func newCertWithExtension(t *testing.T, oid asn1.ObjectIdentifier, value []byte) *x509.Certificate {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generating key: %v", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "test"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtraExtensions: []pkix.Extension{
+			{Id: oid, Value: value},
+		},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("creating certificate: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("parsing certificate: %v", err)
+	}
+	return cert
+}
+
+func TestSctLogIDs(t *testing.T) {
+	var first, second [32]byte
+	first[0], second[0] = 0xAA, 0xBB
+
+	cert := newCertWithExtension(t, oidSCTList, buildSCTListExtension(t, first, second))
+
+	ids, err := sctLogIDs(cert)
+	if err != nil {
+		t.Fatalf("sctLogIDs() error: %v", err)
+	}
+	if len(ids) != 2 {
+		t.Fatalf("got %d log IDs, want 2", len(ids))
+	}
+}
+
+func TestSctLogIDs_NoExtension(t *testing.T) {
+	cert := newCertWithExtension(t, oidBuildSignerURI, []byte("unrelated"))
+
+	if _, err := sctLogIDs(cert); err == nil {
+		t.Error("expected an error for a certificate with no SCT list extension, got nil")
+	}
+}
+
+func TestKeyring_Key_RoundTrip(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generating key: %v", err)
+	}
+	id, err := logID(&key.PublicKey)
+	if err != nil {
+		t.Fatalf("logID() error: %v", err)
+	}
+
+	keyring := &Keyring{keys: map[string]crypto.PublicKey{id: &key.PublicKey}}
+
+	got, err := keyring.Key(id)
+	if err != nil {
+		t.Fatalf("Key(%q) error: %v, want the registered key", id, err)
+	}
+	if got.(*ecdsa.PublicKey) != &key.PublicKey {
+		t.Error("Key() returned a different public key than was registered")
+	}
+
+	if _, err := keyring.Key("0000000000000000000000000000000000000000000000000000000000000000"); err == nil {
+		t.Error("expected an error for an unknown log ID, got nil")
+	}
+}
@@ -12,12 +12,14 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"os"
 	"strings"
 
 	//nolint:staticcheck
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 	"github.com/mitchellh/go-homedir"
+	protobundle "github.com/sigstore/protobuf-specs/gen/pb-go/bundle/v1"
 	rekor "github.com/sigstore/rekor/pkg/client"
 	"github.com/sigstore/rekor/pkg/generated/client/entries"
 	"github.com/sigstore/rekor/pkg/generated/models"
@@ -80,11 +82,56 @@ func (c *SignatureChecker) Verify(target, signature io.Reader) error {
 	return nil
 }
 
-var rekorURL string = "https://rekor.sigstore.dev"
-var tufRootURL string = "tuf-repo-cdn.sigstore.dev"
-var githubActionsOIDIssuer string = "https://token.actions.githubusercontent.com"
+// Defaults used when InstallConfig leaves the corresponding trust root field unset.
+// They point at the public Sigstore deployment, which is sufficient for plugins signed
+// by the usual GitHub Actions + public Rekor/Fulcio/TUF combination.
+var defaultRekorURL string = "https://rekor.sigstore.dev"
+var defaultTUFRootURL string = "tuf-repo-cdn.sigstore.dev"
+var defaultGithubActionsOIDIssuer string = "https://token.actions.githubusercontent.com"
 var tufPath string = "~/.tflint.d/tufdata"
 
+// errTLogEntryNotFound is returned by searchTLogEntries when Rekor has no entry for the artifact.
+// VerifyKeylessWithTimestamp treats this as non-fatal and falls back to timestamp-only verification.
+var errTLogEntryNotFound = errors.New("signature not found in transparency log")
+
+// rekorURL returns the Rekor server to query for transparency log entries, allowing an
+// enterprise to point at a private Rekor instance via InstallConfig.RekorURL.
+func (c *SignatureChecker) rekorURL() string {
+	if c.config.RekorURL != "" {
+		return c.config.RekorURL
+	}
+	return defaultRekorURL
+}
+
+// fulcioOIDCIssuer returns the OIDC issuer expected in the Fulcio certificate, allowing an
+// enterprise to verify plugins signed against a private Fulcio/OIDC deployment via
+// InstallConfig.FulcioOIDCIssuer.
+func (c *SignatureChecker) fulcioOIDCIssuer() string {
+	if c.config.FulcioOIDCIssuer != "" {
+		return c.config.FulcioOIDCIssuer
+	}
+	return defaultGithubActionsOIDIssuer
+}
+
+// tufRootURL returns the TUF repository mirror to fetch the trusted root from, allowing an
+// enterprise to point at a private Sigstore TUF repository via InstallConfig.TUFRootURL.
+func (c *SignatureChecker) tufRootURL() string {
+	if c.config.TUFRootURL != "" {
+		return c.config.TUFRootURL
+	}
+	return defaultTUFRootURL
+}
+
+// expectedSANRegex returns the regex the certificate's SAN must match, defaulting to the
+// configured GitHub repository but overridable via InstallConfig.ExpectedSANRegex for
+// non-GitHub-Actions signers.
+func (c *SignatureChecker) expectedSANRegex() string {
+	if c.config.ExpectedSANRegex != "" {
+		return c.config.ExpectedSANRegex
+	}
+	return fmt.Sprintf("^https://%s/%s/%s/", c.config.SourceHost, c.config.SourceOwner, c.config.SourceRepo)
+}
+
 // In sigstore-go, SignedEntity is assumed to be a parsed Sigstore bundle,
 // but if you implement the interface, it can also be used for entities signed by Cosign.
 // @see https://github.com/sigstore/sigstore-go/blob/v0.1.0/docs/verification.md#abstractions
@@ -95,44 +142,118 @@ type signedEntity struct {
 	certificate []byte
 	signature   []byte
 	tlogs       []*tlog.Entry
+	timestamps  []*bundle.Timestamp
 }
 
 var _ verify.SignedEntity = (*signedEntity)(nil)
 
-func newSignedEntity(artifact, certificate, signature io.ReadSeeker) (*signedEntity, error) {
-	art, err := io.ReadAll(artifact)
+// readAll reads r fully and rewinds it, so the same io.ReadSeeker can be read again by a later
+// verification step (e.g. verify.WithArtifact).
+func readAll(r io.ReadSeeker) ([]byte, error) {
+	b, err := io.ReadAll(r)
 	if err != nil {
 		return nil, err
 	}
-	if _, err := artifact.Seek(0, 0); err != nil {
+	if _, err := r.Seek(0, 0); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// readBase64 reads and rewinds r like readAll, then base64-decodes it. Certificates and
+// signatures are passed around base64-encoded.
+func readBase64(r io.ReadSeeker) ([]byte, error) {
+	encoded, err := readAll(r)
+	if err != nil {
 		return nil, err
 	}
+	return base64.StdEncoding.DecodeString(string(encoded))
+}
 
-	encodedCert, err := io.ReadAll(certificate)
+func (c *SignatureChecker) newSignedEntity(artifact, certificate, signature io.ReadSeeker) (*signedEntity, error) {
+	art, err := readAll(artifact)
+	if err != nil {
+		return nil, err
+	}
+	cert, err := readBase64(certificate)
 	if err != nil {
 		return nil, err
 	}
-	if _, err := certificate.Seek(0, 0); err != nil {
+	sig, err := readBase64(signature)
+	if err != nil {
 		return nil, err
 	}
-	cert, err := base64.StdEncoding.DecodeString(string(encodedCert))
+
+	if c.config.OfflineVerification {
+		return nil, errors.New("offline verification requires a cached transparency log entry; use VerifyKeylessOffline")
+	}
+
+	logs, err := findTLogEntries(c.rekorURL(), art, cert, sig)
 	if err != nil {
 		return nil, err
 	}
 
-	encodedSig, err := io.ReadAll(signature)
+	return &signedEntity{
+		artifact:    art,
+		certificate: cert,
+		signature:   sig,
+		tlogs:       logs,
+	}, nil
+}
+
+// newSignedEntityForTimestampVerification builds a signedEntity like newSignedEntity, but tolerates
+// the artifact having no Rekor transparency log entry: it's used by VerifyKeylessWithTimestamp,
+// which can fall back to the RFC3161 timestamp as its source of trusted time when Rekor doesn't
+// have the artifact. The returned bool reports whether a transparency log entry was found, so the
+// caller knows whether transparency-log-based verification is still available in addition to the
+// timestamp.
+func (c *SignatureChecker) newSignedEntityForTimestampVerification(artifact, certificate, signature io.ReadSeeker) (*signedEntity, bool, error) {
+	art, err := readAll(artifact)
+	if err != nil {
+		return nil, false, err
+	}
+	cert, err := readBase64(certificate)
+	if err != nil {
+		return nil, false, err
+	}
+	sig, err := readBase64(signature)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if c.config.OfflineVerification {
+		return nil, false, errors.New("offline verification requires a cached transparency log entry; use VerifyKeylessOffline")
+	}
+
+	logs, err := findTLogEntries(c.rekorURL(), art, cert, sig)
+	switch {
+	case err == nil:
+		return &signedEntity{artifact: art, certificate: cert, signature: sig, tlogs: logs}, true, nil
+	case errors.Is(err, errTLogEntryNotFound):
+		return &signedEntity{artifact: art, certificate: cert, signature: sig}, false, nil
+	default:
+		return nil, false, err
+	}
+}
+
+// newSignedEntityFromTLogEntry builds a signedEntity the same way newSignedEntity does, but takes
+// the Rekor transparency log entry from a cached sidecar file (tlogEntryFromCache) instead of
+// querying Rekor via findTLogEntries. Used by VerifyKeylessOffline.
+func (c *SignatureChecker) newSignedEntityFromTLogEntry(artifact, certificate, signature, tlogEntry io.ReadSeeker) (*signedEntity, error) {
+	art, err := readAll(artifact)
 	if err != nil {
 		return nil, err
 	}
-	if _, err := signature.Seek(0, 0); err != nil {
+	cert, err := readBase64(certificate)
+	if err != nil {
 		return nil, err
 	}
-	sig, err := base64.StdEncoding.DecodeString(string(encodedSig))
+	sig, err := readBase64(signature)
 	if err != nil {
 		return nil, err
 	}
 
-	logs, err := findTLogEntries(art, cert, sig)
+	entry, err := tlogEntryFromCache(tlogEntry)
 	if err != nil {
 		return nil, err
 	}
@@ -141,10 +262,31 @@ func newSignedEntity(artifact, certificate, signature io.ReadSeeker) (*signedEnt
 		artifact:    art,
 		certificate: cert,
 		signature:   sig,
-		tlogs:       logs,
+		tlogs:       []*tlog.Entry{entry},
 	}, nil
 }
 
+// withTimestamp attaches an RFC3161 timestamp response to an already constructed signedEntity,
+// giving verification a source of trusted time that doesn't depend on the artifact's Rekor entry
+// being reachable.
+func (e *signedEntity) withTimestamp(tsr io.ReadSeeker) (*signedEntity, error) {
+	der, err := io.ReadAll(tsr)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := tsr.Seek(0, 0); err != nil {
+		return nil, err
+	}
+
+	ts, err := bundle.NewTimestampFromResponse(der)
+	if err != nil {
+		return nil, fmt.Errorf("parsing RFC3161 timestamp response: %w", err)
+	}
+	e.timestamps = append(e.timestamps, ts)
+
+	return e, nil
+}
+
 // findTLogEntries searches transparency logs for the artifact signed by Cosign.
 // This is inspired by Cosign's implementation and is equivalent to the logic to get transparency logs
 // in sigstore-go's WithOnlineVerification.
@@ -153,7 +295,28 @@ func newSignedEntity(artifact, certificate, signature io.ReadSeeker) (*signedEnt
 //
 // TODO: Is this safe when the artifact is signed multiple times? Do we need filtering like below?
 // @see https://github.com/sigstore/sigstore-go/blob/v0.1.0/pkg/verify/tlog.go#L164-L179
-func findTLogEntries(artifact, certificate, signature []byte) ([]*tlog.Entry, error) {
+func findTLogEntries(rekorURL string, artifact, certificate, signature []byte) ([]*tlog.Entry, error) {
+	rekorEntries, err := searchTLogEntries(rekorURL, artifact, certificate, signature)
+	if err != nil {
+		return nil, err
+	}
+
+	logs := make([]*tlog.Entry, 0, len(rekorEntries))
+	for _, e := range rekorEntries {
+		entry, err := tlogEntryFromRekor(e)
+		if err != nil {
+			return nil, err
+		}
+		logs = append(logs, entry)
+	}
+
+	return logs, nil
+}
+
+// searchTLogEntries queries Rekor for the raw log entries matching the artifact signed by Cosign.
+// It's split out from findTLogEntries so the raw models.LogEntryAnon entries can also be serialized
+// by WarmVerificationCache for caching and later offline verification.
+func searchTLogEntries(rekorURL string, artifact, certificate, signature []byte) ([]models.LogEntryAnon, error) {
 	searchParams := entries.NewSearchLogQueryParamsWithContext(context.Background())
 	searchLogQuery := models.SearchLogQuery{}
 
@@ -195,37 +358,50 @@ func findTLogEntries(artifact, certificate, signature []byte) ([]*tlog.Entry, er
 		return nil, fmt.Errorf("searching log query: %w", err)
 	}
 	if len(resp.Payload) == 0 {
-		return nil, errors.New("signature not found in transparency log")
+		return nil, errTLogEntryNotFound
 	}
 
-	logs := []*tlog.Entry{}
+	rekorEntries := []models.LogEntryAnon{}
 	for _, logEntry := range resp.GetPayload() {
 		for _, e := range logEntry {
-			decodedBody, err := base64.StdEncoding.DecodeString(e.Body.(string))
-			if err != nil {
-				return nil, err
-			}
-			decodedLogId, err := hex.DecodeString(*e.LogID)
-			if err != nil {
-				return nil, err
-			}
-
-			log, err := tlog.NewEntry(
-				decodedBody,
-				*e.IntegratedTime,
-				*e.LogIndex,
-				decodedLogId,
-				e.Verification.SignedEntryTimestamp,
-				e.Verification.InclusionProof,
-			)
-			if err != nil {
-				return nil, err
-			}
-			logs = append(logs, log)
+			rekorEntries = append(rekorEntries, e)
 		}
 	}
 
-	return logs, nil
+	return rekorEntries, nil
+}
+
+// tlogEntryFromRekor reconstructs a tlog.Entry from a Rekor API log entry, whether it was just
+// fetched by searchTLogEntries or loaded from a cached sidecar file by tlogEntryFromCache.
+func tlogEntryFromRekor(e models.LogEntryAnon) (*tlog.Entry, error) {
+	decodedBody, err := base64.StdEncoding.DecodeString(e.Body.(string))
+	if err != nil {
+		return nil, err
+	}
+	decodedLogId, err := hex.DecodeString(*e.LogID)
+	if err != nil {
+		return nil, err
+	}
+
+	return tlog.NewEntry(
+		decodedBody,
+		*e.IntegratedTime,
+		*e.LogIndex,
+		decodedLogId,
+		e.Verification.SignedEntryTimestamp,
+		e.Verification.InclusionProof,
+	)
+}
+
+// tlogEntryFromCache loads a cached Rekor log entry from a sidecar JSON file placed next to the
+// artifact's `.sig`/`.cert` (holding the JSON WarmVerificationCache returns), so VerifyKeylessOffline can
+// reconstruct the same tlog.Entry searchTLogEntries would have fetched, without contacting Rekor.
+func tlogEntryFromCache(r io.Reader) (*tlog.Entry, error) {
+	var e models.LogEntryAnon
+	if err := json.NewDecoder(r).Decode(&e); err != nil {
+		return nil, fmt.Errorf("parsing cached transparency log entry: %w", err)
+	}
+	return tlogEntryFromRekor(e)
 }
 
 // HasInclustionPromise seems to be a flag that determines whether to verify SET,
@@ -265,58 +441,273 @@ func (e *signedEntity) TlogEntries() ([]*tlog.Entry, error) {
 	return e.tlogs, nil
 }
 
+// Timestamps returns any RFC3161 timestamp responses attached via withTimestamp, giving
+// verify.WithObserverTimestamps/WithSignedTimestamps a non-Rekor source of trusted time.
+func (e *signedEntity) Timestamps() ([]*bundle.Timestamp, error) {
+	return e.timestamps, nil
+}
+
+// keylessVerifierConfig builds the verifier options shared by every keyless
+// verification path (file-based, bundle-based, ...). requireTLog is false only for
+// VerifyKeylessWithTimestamp falling back to a timestamp in place of a missing transparency log
+// entry; every other caller has (or requires) a transparency log entry and passes true.
+//
+// @see https://github.com/sigstore/sigstore-go/blob/v0.1.0/pkg/verify/signed_entity.go#L516-L521
+// @see https://github.com/sigstore/sigstore-go/blob/v0.1.0/pkg/verify/signed_entity.go#L616-L627
+// @see https://github.com/sigstore/sigstore-go/blob/v0.1.0/pkg/verify/signed_entity.go#L654-L659
+func keylessVerifierConfig(requireTLog bool) []verify.VerifierOption {
+	verifierConfig := []verify.VerifierOption{}
+	// Verify SCT using the CT log server's public key to ensure that the certificate was issued by Fulcio in a legitimate manner.
+	verifierConfig = append(verifierConfig, verify.WithSignedCertificateTimestamps(1))
+	if requireTLog {
+		// Verify SET using the Rekor's public key to ensure that the short-lived certificate was valid when the artifact was signed.
+		verifierConfig = append(verifierConfig, verify.WithTransparencyLog(1))
+		verifierConfig = append(verifierConfig, verify.WithIntegratedTimestamps(1))
+		// Note that WithOnlineVerification is not enabled. If online validation is enabled, Tlog will be retrieved based on the log ID
+		// to verify SET and inclusion proof. But this is the same as what we're doing with findTLogEntries.
+		// If not enabled, SET and inclusion proof are verified against TlogEntries. That's enough.
+		// @see https://github.com/sigstore/sigstore-go/blob/v0.1.0/pkg/verify/tlog.go#L81-L113
+	}
+	return verifierConfig
+}
+
+// identityPolicies returns the policy options that verify the signature was made, by default,
+// in GitHub Actions for the configured repository. InstallConfig.FulcioOIDCIssuer and
+// InstallConfig.ExpectedSANRegex replace the expected issuer and SAN regex.
+//
+// @see https://github.com/sigstore/sigstore-go/blob/v0.1.0/pkg/verify/signed_entity.go#L587-L604
+func (c *SignatureChecker) identityPolicies() ([]verify.PolicyOption, error) {
+	certID, err := verify.NewShortCertificateIdentity(c.fulcioOIDCIssuer(), "", "", c.expectedSANRegex())
+	if err != nil {
+		return nil, err
+	}
+	return []verify.PolicyOption{verify.WithCertificateIdentity(certID)}, nil
+}
+
+// trustedRoot returns the Sigstore trusted root: the public keys of Rekor, Fulcio, the CTlog
+// server and any TSAs, plus their rotation history. By default it's fetched from the public
+// Sigstore TUF repository, but InstallConfig.TUFRootJSONPath lets an enterprise bootstrap from a
+// local root.json for a private Sigstore deployment instead of assuming the public CDN.
+func (c *SignatureChecker) trustedRoot() (*root.TrustedRoot, error) {
+	workPath, err := homedir.Expand(tufPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var trustedrootJSON []byte
+	if c.config.TUFRootJSONPath != "" {
+		rootJSON, err := os.ReadFile(c.config.TUFRootJSONPath)
+		if err != nil {
+			return nil, fmt.Errorf("reading TUF root.json: %w", err)
+		}
+		opts := tuf.DefaultOptions()
+		opts.Root = rootJSON
+		opts.RepositoryBaseURL = c.tufRootURL()
+		opts.CachePath = workPath
+		client, err := tuf.New(opts)
+		if err != nil {
+			return nil, err
+		}
+		trustedrootJSON, err = client.GetTarget("trusted_root.json")
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		trustedrootJSON, err = tuf.GetTrustedrootJSON(c.tufRootURL(), workPath)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return root.NewTrustedRootFromJSON(trustedrootJSON)
+}
+
+// trustedMaterial wraps trustedRoot for verify.NewSignedEntityVerifier, which takes a
+// root.TrustedMaterialCollection rather than a single root.TrustedRoot.
+func (c *SignatureChecker) trustedMaterial() (root.TrustedMaterialCollection, error) {
+	trustedRoot, err := c.trustedRoot()
+	if err != nil {
+		return nil, err
+	}
+	return root.TrustedMaterialCollection{trustedRoot}, nil
+}
+
+// verifyKeyring checks that entity's tlog entries (the SET source) and cert's signed certificate
+// timestamps (the SCT source) were issued by logs present in the Rekor and CTFE keyrings loaded
+// from the same trusted root used for verification (see LoadKeyringFromTrustedRoot). It runs for
+// every verification path (VerifyKeyless, VerifyKeylessWithTimestamp, VerifyKeylessOffline,
+// VerifyBundle), surfacing a clear error if a plugin was signed against a Rekor/CTFE shard that
+// has since rotated out, rather than failing deep inside sigstore-go's SET/SCT verification.
+func (c *SignatureChecker) verifyKeyring(entity verify.SignedEntity, cert *x509.Certificate) error {
+	trustedRoot, err := c.trustedRoot()
+	if err != nil {
+		return err
+	}
+	rekorKeyring, ctfeKeyring, err := LoadKeyringFromTrustedRoot(trustedRoot)
+	if err != nil {
+		return err
+	}
+
+	logs, err := entity.TlogEntries()
+	if err != nil {
+		return err
+	}
+	for _, l := range logs {
+		if _, err := rekorKeyring.Key(hex.EncodeToString(l.LogKeyID())); err != nil {
+			return fmt.Errorf("transparency log entry: %w", err)
+		}
+	}
+
+	sctIDs, err := sctLogIDs(cert)
+	if err != nil {
+		return err
+	}
+	for _, id := range sctIDs {
+		if _, err := ctfeKeyring.Key(id); err != nil {
+			return fmt.Errorf("signed certificate timestamp: %w", err)
+		}
+	}
+	return nil
+}
+
 func (c *SignatureChecker) VerifyKeyless(artifact, certificate, signature io.ReadSeeker) error {
-	entity, err := newSignedEntity(artifact, certificate, signature)
+	entity, err := c.newSignedEntity(artifact, certificate, signature)
 	if err != nil {
 		return err
 	}
 
-	verifierConfig := []verify.VerifierOption{}
-	// Verify SCT using the CT log server's public key to ensure that the certificate was issued by Fulcio in a legitimate manner.
-	// @see https://github.com/sigstore/sigstore-go/blob/v0.1.0/pkg/verify/signed_entity.go#L516-L521
-	verifierConfig = append(verifierConfig, verify.WithSignedCertificateTimestamps(1))
-	// Verify SET using the Rekor's public key to ensure that the short-lived certificate was valid when the artifact was signed.
-	// @see https://github.com/sigstore/sigstore-go/blob/v0.1.0/pkg/verify/signed_entity.go#L616-L627
-	// @see https://github.com/sigstore/sigstore-go/blob/v0.1.0/pkg/verify/signed_entity.go#L654-L659
-	verifierConfig = append(verifierConfig, verify.WithTransparencyLog(1))
-	verifierConfig = append(verifierConfig, verify.WithIntegratedTimestamps(1))
-	// Note that WithOnlineVerification is not enabled. If online validation is enabled, Tlog will be retrieved based on the log ID
-	// to verify SET and inclusion proof. But this is the same as what we're doing with findTLogEntries.
-	// If not enabled, SET and inclusion proof are verified against TlogEntries. That's enough.
-	// @see https://github.com/sigstore/sigstore-go/blob/v0.1.0/pkg/verify/tlog.go#L81-L113
-
-	// Verify certificate identity to ensure that the signature was made in GitHub Actions for the repository.
-	// @see https://github.com/sigstore/sigstore-go/blob/v0.1.0/pkg/verify/signed_entity.go#L587-L604
-	identityPolicies := []verify.PolicyOption{}
-	expectedSANRegex := fmt.Sprintf("^https://%s/%s/%s/", c.config.SourceHost, c.config.SourceOwner, c.config.SourceRepo)
-	certID, err := verify.NewShortCertificateIdentity(githubActionsOIDIssuer, "", "", expectedSANRegex)
+	return c.verifyEntity(entity, artifact, true)
+}
+
+// VerifyKeylessWithTimestamp behaves like VerifyKeyless, but additionally verifies the artifact
+// against an RFC3161 timestamp response obtained via `cosign sign-blob --timestamp-server-url`.
+// This gives verification a source of trusted time that doesn't depend on Rekor, so it keeps
+// working if the plugin's Rekor entry is unavailable or if it was signed against a private
+// transparency log that findTLogEntries can't reach: unlike VerifyKeyless, a missing transparency
+// log entry isn't fatal here, since the timestamp can stand in for it. The trusted TSA roots are
+// loaded from the same TUF repository as the Fulcio/Rekor/CTlog keys, via trustedMaterial.
+func (c *SignatureChecker) VerifyKeylessWithTimestamp(artifact, certificate, signature, tsr io.ReadSeeker) error {
+	entity, hasTLogEntry, err := c.newSignedEntityForTimestampVerification(artifact, certificate, signature)
 	if err != nil {
 		return err
 	}
-	identityPolicies = append(identityPolicies, verify.WithCertificateIdentity(certID))
+	if _, err := entity.withTimestamp(tsr); err != nil {
+		return err
+	}
 
-	// Get the public keys of Rekor, Fulcio, CTlog server.
-	// These are the roots and the ends of the chain of trust.
-	workPath, err := homedir.Expand(tufPath)
+	return c.verifyEntity(entity, artifact, hasTLogEntry, verify.WithObserverTimestamps(1), verify.WithSignedTimestamps(1))
+}
+
+// VerifyKeylessOffline behaves like VerifyKeyless, but never contacts Rekor
+// (see InstallConfig.OfflineVerification). tlogEntry must be the sidecar JSON file produced by
+// WarmVerificationCache for this artifact, carrying the SET, inclusion proof, integrated time,
+// log index and log ID that findTLogEntries would otherwise have fetched from Rekor. This lets CI
+// environments pre-fetch verification material once with WarmVerificationCache and then run fully
+// air-gapped, similar to `cosign verify-blob --offline`.
+func (c *SignatureChecker) VerifyKeylessOffline(artifact, certificate, signature, tlogEntry io.ReadSeeker) error {
+	entity, err := c.newSignedEntityFromTLogEntry(artifact, certificate, signature, tlogEntry)
 	if err != nil {
 		return err
 	}
-	var trustedMaterial = make(root.TrustedMaterialCollection, 0)
-	var trustedrootJSON []byte
-	trustedrootJSON, err = tuf.GetTrustedrootJSON(tufRootURL, workPath)
+
+	return c.verifyEntity(entity, artifact, true)
+}
+
+// WarmVerificationCache pre-fetches the Sigstore trusted root and the artifact's Rekor
+// transparency log entry, returning the latter serialized as the sidecar JSON a later
+// VerifyKeylessOffline call expects. Installers can call this once per release asset
+// (e.g. behind a `--download-only` flag) to cache everything verification needs before running
+// air-gapped.
+func (c *SignatureChecker) WarmVerificationCache(artifact, certificate, signature io.ReadSeeker) ([]byte, error) {
+	if _, err := c.trustedMaterial(); err != nil {
+		return nil, fmt.Errorf("warming trusted root cache: %w", err)
+	}
+
+	art, err := readAll(artifact)
+	if err != nil {
+		return nil, err
+	}
+	cert, err := readBase64(certificate)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := readBase64(signature)
+	if err != nil {
+		return nil, err
+	}
+
+	rekorEntries, err := searchTLogEntries(c.rekorURL(), art, cert, sig)
+	if err != nil {
+		return nil, err
+	}
+
+	return cacheEntryJSON(rekorEntries)
+}
+
+// cacheEntryJSON selects and serializes the single Rekor log entry WarmVerificationCache returns for caching.
+// searchTLogEntries only guarantees a non-empty Payload, not a non-empty flattened entry list, and
+// (see the TODO on findTLogEntries) an artifact can have more than one tlog entry. Caching an
+// arbitrary one of several would make VerifyKeylessOffline verify against a not-necessarily-expected
+// entry with no error, so this requires exactly one.
+func cacheEntryJSON(rekorEntries []models.LogEntryAnon) ([]byte, error) {
+	switch len(rekorEntries) {
+	case 0:
+		return nil, errors.New("no transparency log entry found for this artifact")
+	case 1:
+		return json.Marshal(rekorEntries[0])
+	default:
+		return nil, fmt.Errorf("artifact has %d transparency log entries; WarmVerificationCache doesn't support caching one of several for offline verification", len(rekorEntries))
+	}
+}
+
+// VerifyBundle verifies an artifact against a Sigstore protobuf/JSON bundle, as produced by
+// `cosign sign-blob --bundle` or `gh attestation`. Unlike VerifyKeyless, the bundle already
+// carries the certificate chain, signature and tlog entries, so no signedEntity shim or
+// Rekor round-trip (see findTLogEntries) is required to assemble them.
+func (c *SignatureChecker) VerifyBundle(artifact io.ReadSeeker, bundleReader io.Reader) error {
+	var b bundle.Bundle
+	b.Bundle = new(protobundle.Bundle)
+	if err := json.NewDecoder(bundleReader).Decode(&b); err != nil {
+		return fmt.Errorf("parsing sigstore bundle: %w", err)
+	}
+
+	return c.verifyEntity(&b, artifact, true)
+}
+
+// verifyEntity runs the common Sigstore verification pipeline against any verify.SignedEntity,
+// whether it was assembled from separate cert/sig/artifact files (signedEntity) or parsed
+// directly from a Sigstore bundle.
+//
+// In short, here's what Verify does:
+//
+// 1. Verify SET in VerifyObserverTimestamps. The SET is embedded in the Tlog, which can be retrieved
+//    from Rekor based on the artifact's SHA-256. The SET and T logs cannot be tampered with because
+//    they are verified using Rekor's root public key.
+// 2. Verify certificate chain in VerifyLeafCertificate. This allows you to verify that the certificate
+//    passed was issued by Fulcio using Fulcio's root public key. At the same time, it verifies that
+//    the certificate was valid in SET and ensures that the leaked and revoked short-lived certificate
+//    has not been reused.
+// 3. Verify SCT in VerifySignedCertificateTimestamp. This ensures that the certificate was recorded
+//    in CT log servers. The SCT is verified using the CT log server's root public key, so it cannot be tampered with.
+// 4. Verify signature in VerifySignatureWithArtifact. Since the certificate's public key is guaranteed
+//    in previous steps, we use it to verify that the artifact is signed.
+// 5. Verify certificate identity in certificateIdentities.Verify. Since anyone can issue a certificate,
+//    the final step is to verify the certificate's identity. The identity is embedded in a certificate
+//    signed by Fulcio, so there is no room for tampering.
+func (c *SignatureChecker) verifyEntity(entity verify.SignedEntity, artifact io.ReadSeeker, requireTLog bool, extraOpts ...verify.VerifierOption) error {
+	identityPolicies, err := c.identityPolicies()
 	if err != nil {
 		return err
 	}
-	var trustedRoot *root.TrustedRoot
-	trustedRoot, err = root.NewTrustedRootFromJSON(trustedrootJSON)
+
+	material, err := c.trustedMaterial()
 	if err != nil {
 		return err
 	}
-	trustedMaterial = append(trustedMaterial, trustedRoot)
 
 	// Verify signature to ensure that the artifact was signed by the certificate.
 	// @see https://github.com/sigstore/sigstore-go/blob/v0.1.0/pkg/verify/signed_entity.go#L538-L548
-	verifier, err := verify.NewSignedEntityVerifier(trustedMaterial, verifierConfig...)
+	verifier, err := verify.NewSignedEntityVerifier(material, append(keylessVerifierConfig(requireTLog), extraOpts...)...)
 	if err != nil {
 		return err
 	}
@@ -325,27 +716,27 @@ func (c *SignatureChecker) VerifyKeyless(artifact, certificate, signature io.Rea
 		return err
 	}
 
-	// In short, here's what Verify does:
-	//
-	// 1. Verify SET in VerifyObserverTimestamps. The SET is embedded in the Tlog, which can be retrieved
-	//    from Rekor based on the artifact's SHA-256. The SET and T logs cannot be tampered with because
-	//    they are verified using Rekor's root public key.
-	// 2. Verify certificate chain in VerifyLeafCertificate. This allows you to verify that the certificate
-	//    passed was issued by Fulcio using Fulcio's root public key. At the same time, it verifies that
-	//    the certificate was valid in SET and ensures that the leaked and revoked short-lived certificate
-	//    has not been reused.
-	// 3. Verify SCT in VerifySignedCertificateTimestamp. This ensures that the certificate was recorded
-	//    in CT log servers. The SCT is verified using the CT log server's root public key, so it cannot be tampered with.
-	// 4. Verify signature in VerifySignatureWithArtifact. Since the certificate's public key is guaranteed
-	//    in previous steps, we use it to verify that the artifact is signed.
-	// 5. Verify certificate identity in certificateIdentities.Verify. Since anyone can issue a certificate,
-	//    the final step is to verify the certificate's identity. The identity is embedded in a certificate
-	//    signed by Fulcio, so there is no room for tampering.
+	content, err := entity.VerificationContent()
+	if err != nil {
+		return err
+	}
+	cert, err := leafCertificate(content)
+	if err != nil {
+		return err
+	}
+	if err := c.verifyKeyring(entity, cert); err != nil {
+		return err
+	}
+
 	resp, err := verifier.Verify(entity, verify.NewPolicy(artifactPolicy, identityPolicies...))
 	if err != nil {
 		return err
 	}
 
+	if err := c.verifyWorkflowIdentity(cert); err != nil {
+		return err
+	}
+
 	marshaled, err := json.Marshal(resp)
 	if err != nil {
 		return err
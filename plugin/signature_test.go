@@ -0,0 +1,107 @@
+package plugin
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/sigstore/rekor/pkg/generated/models"
+)
+
+func TestCacheEntryJSON(t *testing.T) {
+	entry := models.LogEntryAnon{}
+
+	if _, err := cacheEntryJSON(nil); err == nil {
+		t.Error("expected an error when no transparency log entry was found, got nil")
+	}
+
+	b, err := cacheEntryJSON([]models.LogEntryAnon{entry})
+	if err != nil {
+		t.Fatalf("expected a single entry to be cacheable, got error: %v", err)
+	}
+	if len(b) == 0 {
+		t.Error("expected non-empty JSON for a single entry")
+	}
+
+	if _, err := cacheEntryJSON([]models.LogEntryAnon{entry, entry}); err == nil {
+		t.Error("expected an error when more than one transparency log entry was found, got nil")
+	}
+}
+
+func TestTlogEntryFromCache_InvalidJSON(t *testing.T) {
+	_, err := tlogEntryFromCache(strings.NewReader("not a cached transparency log entry"))
+	if err == nil {
+		t.Fatal("expected an error for a malformed cache file, got nil")
+	}
+	if !strings.Contains(err.Error(), "parsing cached transparency log entry") {
+		t.Errorf("expected a cache-parsing error, got: %v", err)
+	}
+}
+
+func TestSignatureChecker_trustRootDefaults(t *testing.T) {
+	checker := NewSignatureChecker(&InstallConfig{SourceHost: "github.com", SourceOwner: "owner", SourceRepo: "repo"})
+
+	if got := checker.rekorURL(); got != defaultRekorURL {
+		t.Errorf("rekorURL() = %q, want default %q", got, defaultRekorURL)
+	}
+	if got := checker.tufRootURL(); got != defaultTUFRootURL {
+		t.Errorf("tufRootURL() = %q, want default %q", got, defaultTUFRootURL)
+	}
+	if got := checker.fulcioOIDCIssuer(); got != defaultGithubActionsOIDIssuer {
+		t.Errorf("fulcioOIDCIssuer() = %q, want default %q", got, defaultGithubActionsOIDIssuer)
+	}
+	if want, got := "^https://github.com/owner/repo/", checker.expectedSANRegex(); got != want {
+		t.Errorf("expectedSANRegex() = %q, want %q", got, want)
+	}
+}
+
+func TestSignatureChecker_trustRootOverrides(t *testing.T) {
+	checker := NewSignatureChecker(&InstallConfig{
+		SourceHost:       "github.com",
+		SourceOwner:      "owner",
+		SourceRepo:       "repo",
+		RekorURL:         "https://rekor.example.com",
+		TUFRootURL:       "tuf.example.com",
+		FulcioOIDCIssuer: "https://issuer.example.com",
+		ExpectedSANRegex: "^https://example.com/owner/repo/",
+	})
+
+	if got := checker.rekorURL(); got != "https://rekor.example.com" {
+		t.Errorf("rekorURL() = %q, want override", got)
+	}
+	if got := checker.tufRootURL(); got != "tuf.example.com" {
+		t.Errorf("tufRootURL() = %q, want override", got)
+	}
+	if got := checker.fulcioOIDCIssuer(); got != "https://issuer.example.com" {
+		t.Errorf("fulcioOIDCIssuer() = %q, want override", got)
+	}
+	if got := checker.expectedSANRegex(); got != "^https://example.com/owner/repo/" {
+		t.Errorf("expectedSANRegex() = %q, want override", got)
+	}
+}
+
+func TestSignedEntity_WithTimestamp_InvalidResponse(t *testing.T) {
+	entity := &signedEntity{}
+
+	_, err := entity.withTimestamp(strings.NewReader("not an RFC3161 timestamp response"))
+	if err == nil {
+		t.Fatal("expected an error for a malformed timestamp response, got nil")
+	}
+	if !strings.Contains(err.Error(), "parsing RFC3161 timestamp response") {
+		t.Errorf("expected a timestamp-parsing error, got: %v", err)
+	}
+	if len(entity.timestamps) != 0 {
+		t.Errorf("expected no timestamp to be attached on error, got %d", len(entity.timestamps))
+	}
+}
+
+func TestVerifyBundle_InvalidJSON(t *testing.T) {
+	checker := NewSignatureChecker(&InstallConfig{SourceHost: "github.com", SourceOwner: "owner", SourceRepo: "repo"})
+
+	err := checker.VerifyBundle(strings.NewReader("artifact"), strings.NewReader("not a sigstore bundle"))
+	if err == nil {
+		t.Fatal("expected an error for a malformed bundle, got nil")
+	}
+	if !strings.Contains(err.Error(), "parsing sigstore bundle") {
+		t.Errorf("expected a bundle-parsing error, got: %v", err)
+	}
+}
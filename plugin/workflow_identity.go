@@ -0,0 +1,130 @@
+package plugin
+
+import (
+	"crypto/x509"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/sigstore/sigstore-go/pkg/bundle"
+	"github.com/sigstore/sigstore-go/pkg/verify"
+)
+
+// Fulcio embeds GitHub Actions OIDC token claims as custom certificate extensions, so the signer's
+// exact build context can be checked once the certificate chain itself has been verified, without
+// re-contacting GitHub.
+// @see https://github.com/sigstore/fulcio/blob/main/docs/oid-info.md
+var (
+	oidBuildSignerURI      = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 9}
+	oidRunnerEnvironment   = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 11}
+	oidSourceRepositoryURI = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 12}
+)
+
+// verifyWorkflowIdentity enforces InstallConfig.SignerWorkflowRef, SignerRunnerEnvironment and
+// RequireReusableWorkflow against the Fulcio certificate's custom OID extensions.
+//
+// Without this, VerifyKeyless's SAN-prefix check only proves the signature came from *some*
+// workflow in the configured repository, not the specific release workflow we expect - any
+// collaborator able to push a workflow to the repo could otherwise forge a "legitimate" release
+// signature from an unrelated workflow.
+func (c *SignatureChecker) verifyWorkflowIdentity(cert *x509.Certificate) error {
+	if c.config.SignerWorkflowRef == "" && c.config.SignerRunnerEnvironment == "" && !c.config.RequireReusableWorkflow {
+		return nil
+	}
+
+	buildSignerURI, err := certExtension(cert, oidBuildSignerURI)
+	if err != nil {
+		return fmt.Errorf("verifying workflow identity: %w", err)
+	}
+
+	if c.config.RequireReusableWorkflow {
+		sourceRepositoryURI, err := certExtension(cert, oidSourceRepositoryURI)
+		if err != nil {
+			return fmt.Errorf("verifying workflow identity: %w", err)
+		}
+		// A signature made directly by a repository's own workflow (rather than a called
+		// reusable workflow) has a Build Signer URI rooted at that same repository: the Build
+		// Signer URI is "<Source Repository URI>/.github/workflows/<file>@<ref>". A reusable
+		// workflow called from elsewhere is signed by a workflow rooted at a different repository.
+		if strings.HasPrefix(buildSignerURI, sourceRepositoryURI+"/") {
+			return errors.New("certificate was not signed by a reusable workflow, but RequireReusableWorkflow is set")
+		}
+	}
+
+	if c.config.SignerWorkflowRef != "" {
+		matched, err := path.Match(c.config.SignerWorkflowRef, buildSignerURI)
+		if err != nil {
+			return fmt.Errorf("invalid SignerWorkflowRef pattern %q: %w", c.config.SignerWorkflowRef, err)
+		}
+		if !matched {
+			// SignerWorkflowRef is documented (and configured) as a bare "<workflow path>@<ref>"
+			// glob, e.g. ".github/workflows/release.yml@refs/tags/v*", with no scheme/host/owner/
+			// repo. Fall back to matching it against the workflow-path suffix of the full Build
+			// Signer URI.
+			matched, err = path.Match(c.config.SignerWorkflowRef, workflowPathSuffix(buildSignerURI))
+			if err != nil {
+				return fmt.Errorf("invalid SignerWorkflowRef pattern %q: %w", c.config.SignerWorkflowRef, err)
+			}
+		}
+		if !matched {
+			return fmt.Errorf("certificate was signed by workflow %q, expected %q", buildSignerURI, c.config.SignerWorkflowRef)
+		}
+	}
+
+	if c.config.SignerRunnerEnvironment != "" {
+		runnerEnvironment, err := certExtension(cert, oidRunnerEnvironment)
+		if err != nil {
+			return fmt.Errorf("verifying workflow identity: %w", err)
+		}
+		if runnerEnvironment != c.config.SignerRunnerEnvironment {
+			return fmt.Errorf("certificate was signed on runner environment %q, expected %q", runnerEnvironment, c.config.SignerRunnerEnvironment)
+		}
+	}
+
+	return nil
+}
+
+// workflowPathSuffix strips the "https://<host>/<owner>/<repo>/" prefix off a Build Signer URI,
+// leaving the bare "<workflow path>@<ref>" (e.g. ".github/workflows/release.yml@refs/tags/v1.0.0")
+// that SignerWorkflowRef is documented and configured against.
+func workflowPathSuffix(buildSignerURI string) string {
+	rest := buildSignerURI
+	if i := strings.Index(rest, "://"); i >= 0 {
+		rest = rest[i+len("://"):]
+	}
+	parts := strings.SplitN(rest, "/", 4)
+	if len(parts) < 4 {
+		return buildSignerURI
+	}
+	return parts[3]
+}
+
+// certExtension returns the string value of a Fulcio custom certificate extension.
+func certExtension(cert *x509.Certificate, oid asn1.ObjectIdentifier) (string, error) {
+	for _, ext := range cert.Extensions {
+		if !ext.Id.Equal(oid) {
+			continue
+		}
+		var value string
+		if _, err := asn1.Unmarshal(ext.Value, &value); err != nil {
+			// Some Fulcio extensions are stored as a raw UTF-8 string rather than an
+			// ASN.1-encoded one.
+			return string(ext.Value), nil
+		}
+		return value, nil
+	}
+	return "", fmt.Errorf("certificate extension %s not found", oid)
+}
+
+// leafCertificate extracts the signing certificate out of the verify.VerificationContent built by
+// signedEntity.VerificationContent or a parsed Sigstore bundle, so verifyWorkflowIdentity can
+// inspect its Fulcio extensions.
+func leafCertificate(content verify.VerificationContent) (*x509.Certificate, error) {
+	chain, ok := content.(*bundle.CertificateChain)
+	if !ok || len(chain.Certificates) == 0 {
+		return nil, errors.New("signing certificate not found")
+	}
+	return chain.Certificates[0], nil
+}
@@ -0,0 +1,122 @@
+package plugin
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestWorkflowPathSuffix(t *testing.T) {
+	tests := []struct {
+		name string
+		uri  string
+		want string
+	}{
+		{
+			name: "well-formed Build Signer URI",
+			uri:  "https://github.com/owner/repo/.github/workflows/release.yml@refs/tags/v1.0.0",
+			want: ".github/workflows/release.yml@refs/tags/v1.0.0",
+		},
+		{
+			name: "too few path segments",
+			uri:  "https://github.com/owner/repo",
+			want: "https://github.com/owner/repo",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := workflowPathSuffix(tt.uri); got != tt.want {
+				t.Errorf("workflowPathSuffix(%q) = %q, want %q", tt.uri, got, tt.want)
+			}
+		})
+	}
+}
+
+func newCertWithStringExtension(t *testing.T, oid asn1.ObjectIdentifier, value string) *x509.Certificate {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generating key: %v", err)
+	}
+
+	extValue, err := asn1.Marshal(value)
+	if err != nil {
+		t.Fatalf("marshaling extension value: %v", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:    big.NewInt(1),
+		Subject:         pkix.Name{CommonName: "test"},
+		NotBefore:       time.Now(),
+		NotAfter:        time.Now().Add(time.Hour),
+		ExtraExtensions: []pkix.Extension{{Id: oid, Value: extValue}},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("creating certificate: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("parsing certificate: %v", err)
+	}
+	return cert
+}
+
+func TestCertExtension(t *testing.T) {
+	const signerURI = "https://github.com/owner/repo/.github/workflows/release.yml@refs/tags/v1.0.0"
+	cert := newCertWithStringExtension(t, oidBuildSignerURI, signerURI)
+
+	got, err := certExtension(cert, oidBuildSignerURI)
+	if err != nil {
+		t.Fatalf("certExtension() error: %v", err)
+	}
+	if got != signerURI {
+		t.Errorf("certExtension() = %q, want %q", got, signerURI)
+	}
+}
+
+func TestCertExtension_NotFound(t *testing.T) {
+	cert := newCertWithStringExtension(t, oidBuildSignerURI, "https://github.com/owner/repo/.github/workflows/release.yml@refs/tags/v1.0.0")
+
+	if _, err := certExtension(cert, oidRunnerEnvironment); err == nil {
+		t.Error("expected an error for a missing extension, got nil")
+	}
+}
+
+func TestVerifyWorkflowIdentity_SignerWorkflowRefSuffixFallback(t *testing.T) {
+	cert := newCertWithStringExtension(t, oidBuildSignerURI, "https://github.com/owner/repo/.github/workflows/release.yml@refs/tags/v1.0.0")
+
+	checker := NewSignatureChecker(&InstallConfig{
+		SourceHost:        "github.com",
+		SourceOwner:       "owner",
+		SourceRepo:        "repo",
+		SignerWorkflowRef: ".github/workflows/release.yml@refs/tags/v*",
+	})
+
+	if err := checker.verifyWorkflowIdentity(cert); err != nil {
+		t.Errorf("verifyWorkflowIdentity() = %v, want the bare workflow-path glob to match via the suffix fallback", err)
+	}
+}
+
+func TestVerifyWorkflowIdentity_SignerWorkflowRefMismatch(t *testing.T) {
+	cert := newCertWithStringExtension(t, oidBuildSignerURI, "https://github.com/owner/repo/.github/workflows/release.yml@refs/tags/v1.0.0")
+
+	checker := NewSignatureChecker(&InstallConfig{
+		SourceHost:        "github.com",
+		SourceOwner:       "owner",
+		SourceRepo:        "repo",
+		SignerWorkflowRef: ".github/workflows/other.yml@refs/tags/v*",
+	})
+
+	if err := checker.verifyWorkflowIdentity(cert); err == nil {
+		t.Error("expected an error for a non-matching SignerWorkflowRef, got nil")
+	}
+}